@@ -0,0 +1,159 @@
+package uuiddraft
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// generatorReader adapts a single-UUID Read method into an io.Reader that
+// streams raw 16-byte UUIDs, carrying over any bytes of a generated UUID
+// that didn't fit in the caller's buffer to the next Read call.
+type generatorReader struct {
+	read func(id *UUID) error
+	rem  []byte
+}
+
+func (r *generatorReader) Read(p []byte) (int, error) {
+	n := 0
+	for n < len(p) {
+		if len(r.rem) == 0 {
+			var id UUID
+			if err := r.read(&id); err != nil {
+				return n, err
+			}
+			r.rem = id[:]
+		}
+		c := copy(p[n:], r.rem)
+		r.rem = r.rem[c:]
+		n += c
+	}
+	return n, nil
+}
+
+// ReadN fills dst with UUID Version 6 values, requesting the node ID and
+// timestamps under a single lock acquisition instead of once per element.
+func (g *V6Generator) ReadN(dst []UUID) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if len(g.node) == 0 {
+		n, err := newRandomNode(g.rand)
+		if err != nil {
+			return err
+		}
+		g.node = n
+	} else if len(g.node) != 6 {
+		return fmt.Errorf("uuiddraft: node ID must be 6 bytes, got %d", len(g.node))
+	}
+	for i := range dst {
+		n := g.now()
+		if n.Before(g.prevTime) {
+			g.cs = g.cs.Incr()
+		}
+		g.prevTime = n
+
+		t := gregFormat(n)
+		id := &dst[i]
+		binary.BigEndian.PutUint32(id[:4], uint32(t>>28))  // time_high
+		binary.BigEndian.PutUint16(id[4:6], uint16(t>>12)) // time_mid
+		binary.BigEndian.PutUint16(id[6:8], uint16(t))     // time_low_and_version
+		binary.BigEndian.PutUint16(id[8:10], uint16(g.cs)) // clk_seq_hi_res + clk_seq_low
+		copy(id[10:], g.node)                              // node 0-5
+		id[6] = (id[6] & 0x0f) | 0x60                      // ver
+		id[8] = (id[8] & 0x3f) | 0x80                      // var
+	}
+	return nil
+}
+
+// AsReader returns an io.Reader that streams raw 16-byte UUID Version 6
+// values.
+func (g *V6Generator) AsReader() io.Reader {
+	return &generatorReader{read: g.Read}
+}
+
+// ReadN fills dst with UUID Version 7 values, requesting the random bits of
+// all elements in a single rand.Read call instead of one per element. A
+// monotonic generator still reads 2 more bytes each time its counter is
+// reseeded.
+func (g *V7Generator) ReadN(dst []UUID) error {
+	if len(dst) == 0 {
+		return nil
+	}
+	if g.monotonic {
+		return g.readNMonotonic(dst)
+	}
+	return g.readNRandom(dst)
+}
+
+func (g *V7Generator) readNRandom(dst []UUID) error {
+	r := make([]byte, len(dst)*10)
+	if _, err := g.rand.Read(r); err != nil {
+		return err // fail fast
+	}
+	for i := range dst {
+		um := g.now().UnixMilli()
+		id := &dst[i]
+		binary.BigEndian.PutUint32(id[:4], uint32(um>>16)) // unix_ts_ms
+		binary.BigEndian.PutUint16(id[4:6], uint16(um))    // unix_ts_ms
+		copy(id[6:], r[i*10:i*10+10])                      // rand
+		id[6] = (id[6] & 0x0f) | 0x70                      // ver
+		id[8] = (id[8] & 0x3f) | 0x80                      // var
+	}
+	return nil
+}
+
+func (g *V7Generator) readNMonotonic(dst []UUID) error {
+	r := make([]byte, len(dst)*8)
+	if _, err := g.rand.Read(r); err != nil {
+		return err // fail fast
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for i := range dst {
+		um, counter, err := g.stepMonotonic(g.now())
+		if err != nil {
+			return err
+		}
+
+		id := &dst[i]
+		binary.BigEndian.PutUint32(id[:4], uint32(um>>16)) // unix_ts_ms
+		binary.BigEndian.PutUint16(id[4:6], uint16(um))    // unix_ts_ms
+		binary.BigEndian.PutUint16(id[6:8], counter)       // rand_a, 12-bit counter
+		copy(id[8:], r[i*8:i*8+8])                         // rand_b
+		id[6] = (id[6] & 0x0f) | 0x70                      // ver
+		id[8] = (id[8] & 0x3f) | 0x80                      // var
+	}
+	return nil
+}
+
+// AsReader returns an io.Reader that streams raw 16-byte UUID Version 7
+// values.
+func (g *V7Generator) AsReader() io.Reader {
+	return &generatorReader{read: g.Read}
+}
+
+// ReadN fills dst with UUID Version 8 values, requesting all randomness in
+// a single rand.Read call instead of one per element.
+func (g *V8Generator) ReadN(dst []UUID) error {
+	if len(dst) == 0 {
+		return nil
+	}
+	r := make([]byte, len(dst)*16)
+	if _, err := g.r.Read(r); err != nil {
+		return err
+	}
+	for i := range dst {
+		id := &dst[i]
+		copy(id[:], r[i*16:i*16+16])
+		id[6] = (id[6] & 0x0f) | 0x80 // ver
+		id[8] = (id[8] & 0x3f) | 0x80 // var
+	}
+	return nil
+}
+
+// AsReader returns an io.Reader that streams raw 16-byte UUID Version 8
+// values.
+func (g V8Generator) AsReader() io.Reader {
+	return &generatorReader{read: g.Read}
+}
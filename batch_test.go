@@ -0,0 +1,163 @@
+package uuiddraft
+
+import (
+	"io"
+	"testing"
+)
+
+func TestV6Generator_ReadN(t *testing.T) {
+	g := NewV6Generator()
+	dst := make([]UUID, 10)
+	if err := g.ReadN(dst); err != nil {
+		t.Fatalf("ReadN() error = %v", err)
+	}
+	for _, id := range dst {
+		if id.Version() != 6 {
+			t.Errorf("Version() = %v, want %v", id.Version(), 6)
+		}
+	}
+}
+
+func TestV7Generator_ReadN(t *testing.T) {
+	for _, monotonic := range []bool{false, true} {
+		g := NewV7Generator()
+		g.monotonic = monotonic
+		dst := make([]UUID, 1024)
+		if err := g.ReadN(dst); err != nil {
+			t.Fatalf("ReadN() error = %v", err)
+		}
+		for i, id := range dst {
+			if id.Version() != 7 {
+				t.Errorf("Version() = %v, want %v", id.Version(), 7)
+			}
+			if monotonic && i > 0 && Equal(dst[i-1], id) {
+				t.Errorf("ReadN() produced equal consecutive UUIDs at %d", i)
+			}
+		}
+	}
+}
+
+func TestV8Generator_ReadN(t *testing.T) {
+	g := NewV8Generator()
+	dst := make([]UUID, 10)
+	if err := g.ReadN(dst); err != nil {
+		t.Fatalf("ReadN() error = %v", err)
+	}
+	for _, id := range dst {
+		if id.Version() != 8 {
+			t.Errorf("Version() = %v, want %v", id.Version(), 8)
+		}
+	}
+}
+
+func TestAsReader(t *testing.T) {
+	g := NewV7Generator()
+	r := g.AsReader()
+	buf := make([]byte, 16*5)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		t.Fatalf("ReadFull() error = %v", err)
+	}
+	var id UUID
+	copy(id[:], buf[:16])
+	if id.Version() != 7 {
+		t.Errorf("Version() = %v, want %v", id.Version(), 7)
+	}
+}
+
+func TestAsReader_unalignedBuffer(t *testing.T) {
+	// A read size that isn't a multiple of 16 must not make Read stall:
+	// io.ReadFull/io.Copy treat a (0, nil) return as "try again forever".
+	g := NewV7Generator()
+	r := g.AsReader()
+	buf := make([]byte, 16*3+4)
+	n, err := io.ReadFull(r, buf)
+	if err != nil {
+		t.Fatalf("ReadFull() error = %v", err)
+	}
+	if n != len(buf) {
+		t.Errorf("ReadFull() n = %v, want %v", n, len(buf))
+	}
+}
+
+func TestV6Generator_ReadN_badNodeLength(t *testing.T) {
+	g := NewV6Generator(WithNodeID([]byte{0x01, 0x02, 0x03}))
+	if err := g.ReadN(make([]UUID, 2)); err == nil {
+		t.Errorf("ReadN() error = nil, want error")
+	}
+}
+
+// benchBatchSize matches the batch size called out in the ReadN request:
+// "target ≥5x throughput improvement for batches of 1024".
+const benchBatchSize = 1024
+
+func BenchmarkV6Generator_Read(b *testing.B) {
+	g := NewV6Generator()
+	var id UUID
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < benchBatchSize; j++ {
+			if err := g.Read(&id); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkV6Generator_ReadN(b *testing.B) {
+	g := NewV6Generator()
+	dst := make([]UUID, benchBatchSize)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := g.ReadN(dst); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkV7Generator_Read(b *testing.B) {
+	g := NewV7Generator()
+	var id UUID
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < benchBatchSize; j++ {
+			if err := g.Read(&id); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkV7Generator_ReadN(b *testing.B) {
+	g := NewV7Generator()
+	dst := make([]UUID, benchBatchSize)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := g.ReadN(dst); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkV8Generator_Read(b *testing.B) {
+	g := NewV8Generator()
+	var id UUID
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < benchBatchSize; j++ {
+			if err := g.Read(&id); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkV8Generator_ReadN(b *testing.B) {
+	g := NewV8Generator()
+	dst := make([]UUID, benchBatchSize)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := g.ReadN(dst); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
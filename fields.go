@@ -0,0 +1,62 @@
+package uuiddraft
+
+import (
+	"encoding/binary"
+	"time"
+)
+
+// Time returns the timestamp embedded in a v1, v6, or v7 UUID. The second
+// return value reports whether the UUID's version carries a timestamp.
+func (u UUID) Time() (time.Time, bool) {
+	switch u.Version() {
+	case 1:
+		t := int64(binary.BigEndian.Uint32(u[0:4]))
+		t |= int64(binary.BigEndian.Uint16(u[4:6])) << 32
+		t |= int64(binary.BigEndian.Uint16(u[6:8])&0x0fff) << 48
+		return gregTime(t), true
+	case 6:
+		t := int64(binary.BigEndian.Uint32(u[0:4])) << 28
+		t |= int64(binary.BigEndian.Uint16(u[4:6])) << 12
+		t |= int64(binary.BigEndian.Uint16(u[6:8]) & 0x0fff)
+		return gregTime(t), true
+	case 7:
+		ms := int64(binary.BigEndian.Uint32(u[0:4]))<<16 | int64(binary.BigEndian.Uint16(u[4:6]))
+		return time.UnixMilli(ms).UTC(), true
+	default:
+		return time.Time{}, false
+	}
+}
+
+// gregTime reverses gregFormat, turning a 60-bit count of 100-nanosecond
+// intervals since the Gregorian epoch back into a time.Time.
+func gregTime(t int64) time.Time {
+	sec := gregEpoch.Unix() + t/1e7
+	nsec := (t % 1e7) * 100
+	return time.Unix(sec, nsec).UTC()
+}
+
+// ClockSequence returns the 14-bit clock sequence embedded in a v1 or v6
+// UUID. The second return value reports whether the UUID's version carries
+// a clock sequence.
+func (u UUID) ClockSequence() (uint16, bool) {
+	switch u.Version() {
+	case 1, 6:
+		return binary.BigEndian.Uint16(u[8:10]) & 0x3fff, true
+	default:
+		return 0, false
+	}
+}
+
+// Node returns the 6 byte node identifier embedded in a v1 or v6 UUID. The
+// second return value reports whether the UUID's version carries a node
+// identifier.
+func (u UUID) Node() ([]byte, bool) {
+	switch u.Version() {
+	case 1, 6:
+		node := make([]byte, 6)
+		copy(node, u[10:])
+		return node, true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,93 @@
+package uuiddraft
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"testing"
+	"time"
+)
+
+func gregTimeFromHex(s string) time.Time {
+	b, _ := hex.DecodeString(s)
+	ns := binary.BigEndian.Uint64(b) * 100
+	return time.Unix(0, int64(ns)+gregEpoch.UnixNano()).UTC()
+}
+
+func TestUUID_Time(t *testing.T) {
+	tests := []struct {
+		name string
+		uuid UUID
+		want time.Time
+		ok   bool
+	}{
+		{
+			name: "v1",
+			uuid: Must(Parse("C232AB00-9414-11EC-B3C8-9E6BDECED846")),
+			want: gregTimeFromHex("01EC9414C232AB00"),
+			ok:   true,
+		},
+		{
+			name: "v6",
+			uuid: Must(Parse("1EC9414C-232A-6B00-B3C8-9E6BDECED846")),
+			want: gregTimeFromHex("01EC9414C232AB00"),
+			ok:   true,
+		},
+		{
+			name: "v7",
+			uuid: Must(Parse("017F22E2-79B0-7CC3-98C4-DC0C0C07398F")),
+			want: time.UnixMilli(1645557742000).UTC(),
+			ok:   true,
+		},
+		{
+			name: "v4",
+			uuid: Must(Parse("320C3D4D-CC00-475B-8EC9-32D5F69181C0")),
+			ok:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.uuid.Time()
+			if ok != tt.ok {
+				t.Errorf("Time() ok = %v, want %v", ok, tt.ok)
+				return
+			}
+			if ok && !got.Equal(tt.want) {
+				t.Errorf("Time() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUUID_ClockSequence(t *testing.T) {
+	uuid := Must(Parse("1EC9414C-232A-6B00-B3C8-9E6BDECED846"))
+	got, ok := uuid.ClockSequence()
+	if !ok {
+		t.Fatalf("ClockSequence() ok = false, want true")
+	}
+	if want := uint16(0x33c8); got != want {
+		t.Errorf("ClockSequence() = %#x, want %#x", got, want)
+	}
+
+	uuid = Must(Parse("320C3D4D-CC00-475B-8EC9-32D5F69181C0"))
+	if _, ok := uuid.ClockSequence(); ok {
+		t.Errorf("ClockSequence() ok = true, want false")
+	}
+}
+
+func TestUUID_Node(t *testing.T) {
+	uuid := Must(Parse("1EC9414C-232A-6B00-B3C8-9E6BDECED846"))
+	got, ok := uuid.Node()
+	if !ok {
+		t.Fatalf("Node() ok = false, want true")
+	}
+	want := []byte{0x9e, 0x6b, 0xde, 0xce, 0xd8, 0x46}
+	if !bytes.Equal(got, want) {
+		t.Errorf("Node() = %x, want %x", got, want)
+	}
+
+	uuid = Must(Parse("320C3D4D-CC00-475B-8EC9-32D5F69181C0"))
+	if _, ok := uuid.Node(); ok {
+		t.Errorf("Node() ok = true, want false")
+	}
+}
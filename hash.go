@@ -0,0 +1,39 @@
+package uuiddraft
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"hash"
+)
+
+var (
+	NamespaceDNS  = UUID{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+	NamespaceURL  = UUID{0x6b, 0xa7, 0xb8, 0x11, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+	NamespaceOID  = UUID{0x6b, 0xa7, 0xb8, 0x12, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+	NamespaceX500 = UUID{0x6b, 0xa7, 0xb8, 0x14, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+)
+
+// newFromHash generates a UUID from the given hash of the namespace UUID and
+// name, per https://datatracker.ietf.org/doc/html/rfc4122#section-4.3.
+func newFromHash(h hash.Hash, ns UUID, name string, version byte) UUID {
+	h.Write(ns[:])
+	h.Write([]byte(name))
+
+	var id UUID
+	copy(id[:], h.Sum(nil)[:16])
+	id[6] = (id[6] & 0x0f) | (version << 4) // ver
+	id[8] = (id[8] & 0x3f) | 0x80           // var
+	return id
+}
+
+// V3 generates a UUID Version 3 from a namespace UUID and a name, using MD5
+// as the hashing algorithm.
+func V3(ns UUID, name string) UUID {
+	return newFromHash(md5.New(), ns, name, 3)
+}
+
+// V5 generates a UUID Version 5 from a namespace UUID and a name, using
+// SHA-1 as the hashing algorithm.
+func V5(ns UUID, name string) UUID {
+	return newFromHash(sha1.New(), ns, name, 5)
+}
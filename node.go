@@ -0,0 +1,64 @@
+package uuiddraft
+
+import (
+	"errors"
+	"fmt"
+	"net"
+)
+
+// V6GeneratorOption configures a V6Generator constructed with NewV6Generator.
+type V6GeneratorOption func(*V6Generator)
+
+// WithNodeID sets the node ID used by a V6Generator explicitly, bypassing
+// the random/MAC-derived initialisation. An empty node leaves the random
+// initialisation in place; any other length than 6 bytes makes Read and
+// ReadN return an error.
+func WithNodeID(node []byte) V6GeneratorOption {
+	return func(g *V6Generator) {
+		g.node = append([]byte(nil), node...)
+	}
+}
+
+// NewV6GeneratorFromInterface returns a V6Generator whose node ID is the
+// IEEE 802 MAC address of the named network interface, as permitted by
+// RFC 4122 §4.1.6. Unlike the random fallback used by NewV6Generator, the
+// multicast bit is left untouched since this is a real hardware address.
+func NewV6GeneratorFromInterface(name string) (*V6Generator, error) {
+	iface, err := net.InterfaceByName(name)
+	if err != nil {
+		return nil, fmt.Errorf("uuiddraft: could not look up interface %q: %w", name, err)
+	}
+	if len(iface.HardwareAddr) != 6 {
+		return nil, fmt.Errorf("uuiddraft: interface %q has no usable hardware address", name)
+	}
+	return NewV6Generator(WithNodeID(iface.HardwareAddr)), nil
+}
+
+// NewV6GeneratorFromMAC returns a V6Generator whose node ID is the IEEE 802
+// MAC address of the first interface with a usable hardware address that is
+// currently up. It returns an error on platforms without such an interface
+// (e.g. js/wasm or a sandboxed container), in which case callers should fall
+// back to NewV6Generator's random node ID.
+func NewV6GeneratorFromMAC() (*V6Generator, error) {
+	node, err := firstInterfaceHardwareAddr()
+	if err != nil {
+		return nil, err
+	}
+	return NewV6Generator(WithNodeID(node)), nil
+}
+
+func firstInterfaceHardwareAddr() ([]byte, error) {
+	ifaces, err := net.Interfaces()
+	if err != nil {
+		return nil, fmt.Errorf("uuiddraft: could not list interfaces: %w", err)
+	}
+	for _, iface := range ifaces {
+		if iface.Flags&net.FlagUp == 0 {
+			continue
+		}
+		if len(iface.HardwareAddr) == 6 {
+			return iface.HardwareAddr, nil
+		}
+	}
+	return nil, errors.New("uuiddraft: no interface with a usable hardware address found")
+}
@@ -0,0 +1,25 @@
+package uuiddraft
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestWithNodeID(t *testing.T) {
+	node := []byte{0x00, 0x11, 0x22, 0x33, 0x44, 0x55}
+	g := NewV6Generator(WithNodeID(node))
+
+	var got UUID
+	if err := g.Read(&got); err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+	if n, ok := got.Node(); !ok || !bytes.Equal(n, node) {
+		t.Errorf("Node() = %x, %v, want %x, true", n, ok, node)
+	}
+}
+
+func TestNewV6GeneratorFromInterface_unknown(t *testing.T) {
+	if _, err := NewV6GeneratorFromInterface("does-not-exist-0"); err == nil {
+		t.Errorf("NewV6GeneratorFromInterface() error = nil, want error")
+	}
+}
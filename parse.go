@@ -0,0 +1,66 @@
+package uuiddraft
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// Parse parses a UUID from any of the four standard string encodings:
+//
+//	xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
+//	urn:uuid:xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
+//	{xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx}
+//	xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx
+//
+// Parsing is case-insensitive.
+func Parse(s string) (UUID, error) {
+	return ParseBytes([]byte(s))
+}
+
+// ParseBytes is like Parse but parses a UUID from a byte slice, avoiding an
+// allocation when the caller already has the representation as bytes.
+func ParseBytes(b []byte) (UUID, error) {
+	switch len(b) {
+	case 45: // urn:uuid:xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
+		if !strings.EqualFold(string(b[:9]), "urn:uuid:") {
+			return UUID{}, fmt.Errorf("uuiddraft: invalid UUID format: missing \"urn:uuid:\" prefix: %w", ErrInvalidUUID)
+		}
+		return ParseBytes(b[9:])
+	case 38: // {xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx}
+		if b[0] != '{' || b[37] != '}' {
+			return UUID{}, fmt.Errorf("uuiddraft: invalid UUID format: missing braces: %w", ErrInvalidUUID)
+		}
+		return ParseBytes(b[1:37])
+	case 36: // xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
+		for _, i := range [...]int{8, 13, 18, 23} {
+			if b[i] != '-' {
+				return UUID{}, fmt.Errorf("uuiddraft: invalid UUID format: expected '-' at position %d: %w", i, ErrInvalidUUID)
+			}
+		}
+		var src [32]byte
+		copy(src[0:8], b[0:8])
+		copy(src[8:12], b[9:13])
+		copy(src[12:16], b[14:18])
+		copy(src[16:20], b[19:23])
+		copy(src[20:32], b[24:36])
+		var id UUID
+		if _, err := hex.Decode(id[:], src[:]); err != nil {
+			return UUID{}, fmt.Errorf("uuiddraft: invalid UUID format: %w", err)
+		}
+		return id, nil
+	case 32: // xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx
+		var id UUID
+		if _, err := hex.Decode(id[:], b); err != nil {
+			return UUID{}, fmt.Errorf("uuiddraft: invalid UUID format: %w", err)
+		}
+		return id, nil
+	default:
+		return UUID{}, fmt.Errorf("uuiddraft: invalid UUID length %d: %w", len(b), ErrInvalidUUID)
+	}
+}
+
+// MustParse is like Parse but panics if s cannot be parsed.
+func MustParse(s string) UUID {
+	return Must(Parse(s))
+}
@@ -0,0 +1,61 @@
+package uuiddraft
+
+import (
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	want := Must(Parse("1ec9414c-232a-6b00-b3c8-9e6bdeced846"))
+	tests := []struct {
+		name string
+		raw  string
+	}{
+		{name: "hex-and-dash", raw: "1EC9414C-232A-6B00-B3C8-9E6BDECED846"},
+		{name: "hex-and-dash lower", raw: "1ec9414c-232a-6b00-b3c8-9e6bdeced846"},
+		{name: "urn", raw: "urn:uuid:1EC9414C-232A-6B00-B3C8-9E6BDECED846"},
+		{name: "urn upper prefix", raw: "URN:UUID:1EC9414C-232A-6B00-B3C8-9E6BDECED846"},
+		{name: "braces", raw: "{1EC9414C-232A-6B00-B3C8-9E6BDECED846}"},
+		{name: "hex-only", raw: "1EC9414C232A6B00B3C89E6BDECED846"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.raw)
+			if err != nil {
+				t.Errorf("Parse() error = %v", err)
+				return
+			}
+			if !Equal(got, want) {
+				t.Errorf("Parse() got = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestParse_invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+	}{
+		{name: "wrong length", raw: "1EC9414C-232A-6B00-B3C8"},
+		{name: "missing first dash", raw: "1EC9414Cx232A-6B00-B3C8-9E6BDECED846"},
+		{name: "missing last dash", raw: "1EC9414C-232A-6B00-B3C8x9E6BDECED846"},
+		{name: "bad braces", raw: "[1EC9414C-232A-6B00-B3C8-9E6BDECED846]"},
+		{name: "bad urn prefix", raw: "urn:notuuid:1EC9414C-232A-6B00-B3C8-9E6BDECED846"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse(tt.raw); err == nil {
+				t.Errorf("Parse() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestMustParse(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("MustParse() did not panic")
+		}
+	}()
+	MustParse("not-a-uuid")
+}
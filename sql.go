@@ -0,0 +1,180 @@
+package uuiddraft
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// Scan implements the sql.Scanner interface, allowing a UUID to be read
+// directly out of a database/sql column holding either the 16 raw bytes or
+// the 36-char hex-and-dash string form.
+func (u *UUID) Scan(src interface{}) error {
+	switch src := src.(type) {
+	case nil:
+		return fmt.Errorf("uuiddraft: cannot scan NULL into UUID")
+	case []byte:
+		if len(src) == 16 {
+			copy(u[:], src)
+			return nil
+		}
+		id, err := Parse(string(src))
+		if err != nil {
+			return err
+		}
+		*u = id
+		return nil
+	case string:
+		id, err := Parse(src)
+		if err != nil {
+			return err
+		}
+		*u = id
+		return nil
+	default:
+		return fmt.Errorf("uuiddraft: cannot scan %T into UUID", src)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+func (u UUID) Value() (driver.Value, error) {
+	return u.String(), nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (u UUID) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (u *UUID) UnmarshalText(text []byte) error {
+	id, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*u = id
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (u UUID) MarshalBinary() ([]byte, error) {
+	return u[:], nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (u *UUID) UnmarshalBinary(data []byte) error {
+	if len(data) != 16 {
+		return fmt.Errorf("uuiddraft: invalid UUID (got %d bytes)", len(data))
+	}
+	copy(u[:], data)
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (u UUID) MarshalJSON() ([]byte, error) {
+	return json.Marshal(u.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (u *UUID) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	id, err := Parse(s)
+	if err != nil {
+		return err
+	}
+	*u = id
+	return nil
+}
+
+// NullUUID represents a UUID that may be NULL. NullUUID implements the same
+// set of interfaces as UUID (sql.Scanner, driver.Valuer, the encoding
+// Text/Binary marshalers, and json.Marshaler) so it can be used as a scan
+// destination and query argument, mirroring sql.NullString.
+type NullUUID struct {
+	UUID  UUID
+	Valid bool
+}
+
+// Scan implements the sql.Scanner interface.
+func (n *NullUUID) Scan(src interface{}) error {
+	if src == nil {
+		n.UUID, n.Valid = UUID{}, false
+		return nil
+	}
+	n.Valid = true
+	return n.UUID.Scan(src)
+}
+
+// Value implements the driver.Valuer interface.
+func (n NullUUID) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.UUID.Value()
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (n NullUUID) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.UUID.MarshalText()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (n *NullUUID) UnmarshalText(text []byte) error {
+	if string(text) == "null" {
+		n.UUID, n.Valid = UUID{}, false
+		return nil
+	}
+	if err := n.UUID.UnmarshalText(text); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (n NullUUID) MarshalBinary() ([]byte, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.UUID.MarshalBinary()
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (n *NullUUID) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		n.UUID, n.Valid = UUID{}, false
+		return nil
+	}
+	if err := n.UUID.UnmarshalBinary(data); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (n NullUUID) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.UUID.MarshalJSON()
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (n *NullUUID) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.UUID, n.Valid = UUID{}, false
+		return nil
+	}
+	if err := n.UUID.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
@@ -0,0 +1,174 @@
+package uuiddraft
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestUUID_Scan(t *testing.T) {
+	want := Must(Parse("1EC9414C-232A-6B00-B3C8-9E6BDECED846"))
+	tests := []struct {
+		name string
+		src  interface{}
+	}{
+		{name: "bytes", src: want[:]},
+		{name: "string", src: want.String()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got UUID
+			if err := got.Scan(tt.src); err != nil {
+				t.Errorf("Scan() error = %v", err)
+				return
+			}
+			if !Equal(got, want) {
+				t.Errorf("Scan() got = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestUUID_Scan_nil(t *testing.T) {
+	var got UUID
+	if err := got.Scan(nil); err == nil {
+		t.Errorf("Scan() error = nil, want error")
+	}
+}
+
+func TestUUID_Value(t *testing.T) {
+	want := Must(Parse("1EC9414C-232A-6B00-B3C8-9E6BDECED846"))
+	got, err := want.Value()
+	if err != nil {
+		t.Errorf("Value() error = %v", err)
+		return
+	}
+	if got != want.String() {
+		t.Errorf("Value() = %v, want %v", got, want.String())
+	}
+}
+
+func TestUUID_JSON(t *testing.T) {
+	want := Must(Parse("1EC9414C-232A-6B00-B3C8-9E6BDECED846"))
+	b, err := json.Marshal(want)
+	if err != nil {
+		t.Errorf("Marshal() error = %v", err)
+		return
+	}
+	var got UUID
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Errorf("Unmarshal() error = %v", err)
+		return
+	}
+	if !Equal(got, want) {
+		t.Errorf("Unmarshal() got = %v, want %v", got, want)
+	}
+}
+
+func TestUUID_MarshalBinary(t *testing.T) {
+	want := Must(Parse("1EC9414C-232A-6B00-B3C8-9E6BDECED846"))
+	b, err := want.MarshalBinary()
+	if err != nil {
+		t.Errorf("MarshalBinary() error = %v", err)
+		return
+	}
+	var got UUID
+	if err := got.UnmarshalBinary(b); err != nil {
+		t.Errorf("UnmarshalBinary() error = %v", err)
+		return
+	}
+	if !Equal(got, want) {
+		t.Errorf("UnmarshalBinary() got = %v, want %v", got, want)
+	}
+}
+
+func TestNullUUID_JSON(t *testing.T) {
+	tests := []struct {
+		name string
+		in   NullUUID
+	}{
+		{name: "valid", in: NullUUID{UUID: Must(Parse("1EC9414C-232A-6B00-B3C8-9E6BDECED846")), Valid: true}},
+		{name: "null", in: NullUUID{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := json.Marshal(tt.in)
+			if err != nil {
+				t.Errorf("Marshal() error = %v", err)
+				return
+			}
+			var got NullUUID
+			if err := json.Unmarshal(b, &got); err != nil {
+				t.Errorf("Unmarshal() error = %v", err)
+				return
+			}
+			if got != tt.in {
+				t.Errorf("Unmarshal() got = %v, want %v", got, tt.in)
+			}
+		})
+	}
+}
+
+func TestNullUUID_Scan(t *testing.T) {
+	var got NullUUID
+	if err := got.Scan(nil); err != nil {
+		t.Errorf("Scan() error = %v", err)
+		return
+	}
+	if got.Valid {
+		t.Errorf("Scan() Valid = true, want false")
+	}
+}
+
+func TestNullUUID_Text(t *testing.T) {
+	tests := []struct {
+		name string
+		in   NullUUID
+	}{
+		{name: "valid", in: NullUUID{UUID: Must(Parse("1EC9414C-232A-6B00-B3C8-9E6BDECED846")), Valid: true}},
+		{name: "null", in: NullUUID{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := tt.in.MarshalText()
+			if err != nil {
+				t.Errorf("MarshalText() error = %v", err)
+				return
+			}
+			var got NullUUID
+			if err := got.UnmarshalText(b); err != nil {
+				t.Errorf("UnmarshalText() error = %v", err)
+				return
+			}
+			if got != tt.in {
+				t.Errorf("UnmarshalText() got = %v, want %v", got, tt.in)
+			}
+		})
+	}
+}
+
+func TestNullUUID_Binary(t *testing.T) {
+	tests := []struct {
+		name string
+		in   NullUUID
+	}{
+		{name: "valid", in: NullUUID{UUID: Must(Parse("1EC9414C-232A-6B00-B3C8-9E6BDECED846")), Valid: true}},
+		{name: "null", in: NullUUID{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := tt.in.MarshalBinary()
+			if err != nil {
+				t.Errorf("MarshalBinary() error = %v", err)
+				return
+			}
+			var got NullUUID
+			if err := got.UnmarshalBinary(b); err != nil {
+				t.Errorf("UnmarshalBinary() error = %v", err)
+				return
+			}
+			if got != tt.in {
+				t.Errorf("UnmarshalBinary() got = %v, want %v", got, tt.in)
+			}
+		})
+	}
+}
@@ -13,6 +13,8 @@ import (
 )
 
 var (
+	DefaultV1Generator = NewV1Generator()
+	DefaultV4Generator = NewV4Generator()
 	DefaultV6Generator = NewV6Generator()
 	DefaultV7Generator = NewV7Generator()
 	DefaultV8Generator = NewV8Generator()
@@ -84,6 +86,19 @@ var (
 	gregEpoch = time.Date(1582, time.October, 15, 0, 0, 0, 0, time.UTC)
 )
 
+// newRandomNode generates an arbitrary 6 byte node ID, used as a fallback by
+// the time-based generators when no other node ID was supplied. Per RFC 4122
+// §4.1.6, the multicast bit is set to mark it as not derived from an IEEE
+// 802 MAC address.
+func newRandomNode(r io.Reader) ([]byte, error) {
+	b := make([]byte, 6)
+	if _, err := r.Read(b); err != nil {
+		return nil, fmt.Errorf("could not initialise node ID: %w", err) // fail fast
+	}
+	b[0] |= 0x01 // multicast bit
+	return b, nil
+}
+
 type V6Generator struct {
 	now  func() time.Time
 	rand io.Reader
@@ -94,12 +109,16 @@ type V6Generator struct {
 	prevTime time.Time
 }
 
-func NewV6Generator() *V6Generator {
-	return &V6Generator{
+func NewV6Generator(opts ...V6GeneratorOption) *V6Generator {
+	g := &V6Generator{
 		now:  time.Now,
 		cs:   randomClockSequence(),
 		rand: rand.Reader,
 	}
+	for _, opt := range opts {
+		opt(g)
+	}
+	return g
 }
 
 // gregFormat returns a 60-bit timestamp represented by UTC as
@@ -114,12 +133,15 @@ func (g *V6Generator) Read(id *UUID) error {
 	g.mu.Lock()
 	if len(g.node) == 0 {
 		// init arbitrary node ID when it is not set.
-		r := make([]byte, 6)
-		if _, err := g.rand.Read(r); err != nil {
+		n, err := newRandomNode(g.rand)
+		if err != nil {
 			g.mu.Unlock()
-			return fmt.Errorf("could not initialise node ID: %w", err) // fail fast
+			return err
 		}
-		g.node = r
+		g.node = n
+	} else if len(g.node) != 6 {
+		g.mu.Unlock()
+		return fmt.Errorf("uuiddraft: node ID must be 6 bytes, got %d", len(g.node))
 	}
 	n := g.now()
 	if n.Before(g.prevTime) {
@@ -149,43 +171,6 @@ func V6() (UUID, error) {
 	return id, nil
 }
 
-type V7Generator struct {
-	now  func() time.Time
-	rand io.Reader
-}
-
-func NewV7Generator() *V7Generator {
-	return &V7Generator{
-		now:  time.Now,
-		rand: rand.Reader,
-	}
-}
-
-// Generate generates a UUID Version 7 based on
-// https://www.ietf.org/archive/id/draft-ietf-uuidrev-rfc4122bis-00.html#name-uuid-version-7
-func (g V7Generator) Read(id *UUID) error {
-	r := make([]byte, 10)
-	if _, err := g.rand.Read(r); err != nil {
-		return err // fail fast
-	}
-	um := g.now().UnixMilli()
-	binary.BigEndian.PutUint32(id[:4], uint32(um>>16)) // unix_ts_ms
-	binary.BigEndian.PutUint16(id[4:6], uint16(um))    // unix_ts_ms
-	copy(id[6:], r)                                    // rand
-	id[6] = (id[6] & 0x0f) | 0x70                      // ver
-	id[8] = (id[8] & 0x3f) | 0x80                      // var
-	return nil
-}
-
-// V7 reads a UUID from DefaultV7Generator.
-func V7() (UUID, error) {
-	var id UUID
-	if err := DefaultV7Generator.Read(&id); err != nil {
-		return UUID{}, err
-	}
-	return id, nil
-}
-
 type V8Generator struct {
 	r io.Reader
 }
@@ -224,19 +209,3 @@ func Must(uuid UUID, err error) UUID {
 }
 
 var ErrInvalidUUID = errors.New("invalid UUID")
-
-// Parse parses the "hex-and-dash" string representation of a UUID.
-//
-// Format: XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX
-func Parse(raw string) (UUID, error) {
-	if len(raw) != 36 {
-		return UUID{}, ErrInvalidUUID
-	}
-	if raw[8] != '-' && raw[13] != '-' && raw[18] != '-' && raw[23] != '-' {
-		return UUID{}, ErrInvalidUUID
-	}
-	src := raw[:8] + raw[9:13] + raw[14:18] + raw[19:23] + raw[24:]
-	id := UUID{}
-	_, err := hex.Decode(id[:], []byte(src))
-	return id, err
-}
@@ -96,7 +96,7 @@ func TestV6Generator_Read(t *testing.T) {
 			return time.Unix(0, int64(ns)+gregEpoch.UnixNano())
 		},
 		cs:   0x33c8,
-		rand: bytes.NewReader([]byte{0x9e, 0x6b, 0xde, 0xce, 0xd8, 0x46}),
+		node: []byte{0x9e, 0x6b, 0xde, 0xce, 0xd8, 0x46},
 	}
 	var got UUID
 	err := g.Read(&got)
@@ -110,29 +110,18 @@ func TestV6Generator_Read(t *testing.T) {
 	}
 }
 
-func TestV7Generator_Read(t *testing.T) {
-	// https://www.ietf.org/archive/id/draft-ietf-uuidrev-rfc4122bis-00.html#name-example-of-a-uuidv7-value
-	// -------------------------------
-	// field      bits    value
-	// -------------------------------
-	// unix_ts_ms   48    0x17F22E279B0
-	// ver           4    0x7
-	// rand_a       12    0xCC3
-	// var           2    b10
-	// rand_b       62    b01, 0x8C4DC0C0C07398F
-	// -------------------------------
-	// total       128
-	// -------------------------------
-	// final: 017F22E2-79B0-7CC3-98C4-DC0C0C07398F
+func TestV1Generator_Read(t *testing.T) {
+	// Same time_low/mid/hi fields as TestV6Generator_Read, rearranged into
+	// the version 1 field layout.
 	fmt.Println()
-	g := V7Generator{
+	g := V1Generator{
 		now: func() time.Time {
-			return time.UnixMilli(1645557742000)
+			b, _ := hex.DecodeString("01EC9414C232AB00")
+			ns := binary.BigEndian.Uint64(b) * 100
+			return time.Unix(0, int64(ns)+gregEpoch.UnixNano())
 		},
-		rand: bytes.NewReader([]byte{
-			0x0c, 0xc3,
-			0x18, 0xc4, 0xdc, 0x0c, 0x0c, 0x07, 0x39, 0x8f,
-		}),
+		cs:   0x33c8,
+		node: []byte{0x9e, 0x6b, 0xde, 0xce, 0xd8, 0x46},
 	}
 	var got UUID
 	err := g.Read(&got)
@@ -140,12 +129,45 @@ func TestV7Generator_Read(t *testing.T) {
 		t.Errorf("Read() error = %v", err)
 		return
 	}
-	want := Must(Parse("017F22E2-79B0-7CC3-98C4-DC0C0C07398F"))
+	want := Must(Parse("C232AB00-9414-11EC-B3C8-9E6BDECED846"))
 	if !Equal(got, want) {
 		t.Errorf("Read() got = %v, want %v", got, want)
 	}
 }
 
+func TestV4Generator_Read(t *testing.T) {
+	var got UUID
+	err := NewV4Generator().Read(&got)
+	if err != nil {
+		t.Errorf("Read() error = %v", err)
+		return
+	}
+	if got.Version() != 4 {
+		t.Errorf("Version() = %v, want %v", got.Version(), 4)
+	}
+	if got.Variant() != 2 {
+		t.Errorf("Variant() = %v, want %v", got.Variant(), 2)
+	}
+}
+
+func TestV3(t *testing.T) {
+	// https://docs.python.org/3/library/uuid.html#uuid.uuid3
+	got := V3(NamespaceDNS, "python.org")
+	want := Must(Parse("6fa459ea-ee8a-3ca4-894e-db77e160355e"))
+	if !Equal(got, want) {
+		t.Errorf("V3() = %v, want %v", got, want)
+	}
+}
+
+func TestV5(t *testing.T) {
+	// https://docs.python.org/3/library/uuid.html#uuid.uuid5
+	got := V5(NamespaceDNS, "python.org")
+	want := Must(Parse("886313e1-3b8a-5372-9b90-0c9aee199e5d"))
+	if !Equal(got, want) {
+		t.Errorf("V5() = %v, want %v", got, want)
+	}
+}
+
 func TestV8Generator_Read(t *testing.T) {
 	// https://www.ietf.org/archive/id/draft-ietf-uuidrev-rfc4122bis-00.html#name-example-of-a-uuidv8-value
 	// -------------------------------
@@ -0,0 +1,68 @@
+package uuiddraft
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"sync"
+	"time"
+)
+
+type V1Generator struct {
+	now  func() time.Time
+	rand io.Reader
+
+	node     []byte
+	cs       clockSequence
+	mu       sync.Mutex
+	prevTime time.Time
+}
+
+func NewV1Generator() *V1Generator {
+	return &V1Generator{
+		now:  time.Now,
+		cs:   randomClockSequence(),
+		rand: rand.Reader,
+	}
+}
+
+// Read generates a UUID Version 1 based on
+// https://datatracker.ietf.org/doc/html/rfc4122#section-4.2.
+func (g *V1Generator) Read(id *UUID) error {
+	g.mu.Lock()
+	if len(g.node) == 0 {
+		n, err := newRandomNode(g.rand)
+		if err != nil {
+			g.mu.Unlock()
+			return err
+		}
+		g.node = n
+	}
+	n := g.now()
+	if n.Before(g.prevTime) {
+		g.cs = g.cs.Incr()
+	}
+	g.prevTime = n
+	cs := g.cs
+	node := g.node
+	g.mu.Unlock()
+
+	t := gregFormat(n)
+	binary.BigEndian.PutUint32(id[:4], uint32(t))      // time_low
+	binary.BigEndian.PutUint16(id[4:6], uint16(t>>32)) // time_mid
+	binary.BigEndian.PutUint16(id[6:8], uint16(t>>48)) // time_hi_and_version
+	binary.BigEndian.PutUint16(id[8:10], uint16(cs))   // clk_seq_hi_res + clk_seq_low
+	copy(id[10:], node)                                // node 0-5
+	id[6] = (id[6] & 0x0f) | 0x10                      // ver
+	id[8] = (id[8] & 0x3f) | 0x80                      // var
+	return nil
+}
+
+// V1 reads a UUID from DefaultV1Generator.
+func V1() (UUID, error) {
+	var id UUID
+	if err := DefaultV1Generator.Read(&id); err != nil {
+		return UUID{}, err
+	}
+	return id, nil
+}
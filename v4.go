@@ -0,0 +1,36 @@
+package uuiddraft
+
+import (
+	"crypto/rand"
+	"io"
+)
+
+type V4Generator struct {
+	r io.Reader
+}
+
+func NewV4Generator() *V4Generator {
+	return &V4Generator{r: rand.Reader}
+}
+
+// Read generates a UUID Version 4 based on
+// https://datatracker.ietf.org/doc/html/rfc4122#section-4.4.
+func (g V4Generator) Read(id *UUID) error {
+	b := make([]byte, 16)
+	if _, err := g.r.Read(b); err != nil {
+		return err
+	}
+	copy(id[:], b)
+	id[6] = (id[6] & 0x0f) | 0x40 // ver
+	id[8] = (id[8] & 0x3f) | 0x80 // var
+	return nil
+}
+
+// V4 reads a UUID from DefaultV4Generator.
+func V4() (UUID, error) {
+	var id UUID
+	if err := DefaultV4Generator.Read(&id); err != nil {
+		return UUID{}, err
+	}
+	return id, nil
+}
@@ -0,0 +1,84 @@
+package uuiddraft
+
+import (
+	"bytes"
+	"crypto/rand"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestV7Generator_Read(t *testing.T) {
+	// https://www.ietf.org/archive/id/draft-ietf-uuidrev-rfc4122bis-00.html#name-example-of-a-uuidv7-value
+	// -------------------------------
+	// field      bits    value
+	// -------------------------------
+	// unix_ts_ms   48    0x17F22E279B0
+	// ver           4    0x7
+	// rand_a       12    0xCC3
+	// var           2    b10
+	// rand_b       62    b01, 0x8C4DC0C0C07398F
+	// -------------------------------
+	// total       128
+	// -------------------------------
+	// final: 017F22E2-79B0-7CC3-98C4-DC0C0C07398F
+	fmt.Println()
+	g := V7Generator{
+		now: func() time.Time {
+			return time.UnixMilli(1645557742000)
+		},
+		rand: bytes.NewReader([]byte{
+			0x0c, 0xc3,
+			0x18, 0xc4, 0xdc, 0x0c, 0x0c, 0x07, 0x39, 0x8f,
+		}),
+	}
+	var got UUID
+	err := g.Read(&got)
+	if (err != nil) != false {
+		t.Errorf("Read() error = %v", err)
+		return
+	}
+	want := Must(Parse("017F22E2-79B0-7CC3-98C4-DC0C0C07398F"))
+	if !Equal(got, want) {
+		t.Errorf("Read() got = %v, want %v", got, want)
+	}
+}
+
+func TestV7Generator_Read_monotonic(t *testing.T) {
+	now := time.UnixMilli(1645557742000)
+	g := V7Generator{
+		now:       func() time.Time { return now },
+		rand:      rand.Reader,
+		monotonic: true,
+	}
+	var prev UUID
+	for i := 0; i < 1000; i++ {
+		var got UUID
+		if err := g.Read(&got); err != nil {
+			t.Fatalf("Read() error = %v", err)
+		}
+		if i > 0 && bytes.Compare(prev[:], got[:]) >= 0 {
+			t.Fatalf("Read() #%d = %v, want greater than %v", i, got, prev)
+		}
+		prev = got
+	}
+}
+
+func TestV7Generator_Read_monotonicCounterOverflow(t *testing.T) {
+	now := time.UnixMilli(1645557742000)
+	g := V7Generator{
+		now:       func() time.Time { return now },
+		rand:      rand.Reader,
+		monotonic: true,
+		counter:   counterMax,
+		lastMs:    now.UnixMilli(),
+	}
+	var got UUID
+	if err := g.Read(&got); err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+	gotMs := int64(got[0])<<40 | int64(got[1])<<32 | int64(got[2])<<24 | int64(got[3])<<16 | int64(got[4])<<8 | int64(got[5])
+	if gotMs != now.UnixMilli()+1 {
+		t.Errorf("unix_ts_ms = %v, want %v", gotMs, now.UnixMilli()+1)
+	}
+}